@@ -4,6 +4,7 @@
 package disk
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -20,14 +21,19 @@ const volumeNameBufferLength = uint32(windows.MAX_PATH + 1)
 const volumePathBufferLength = volumeNameBufferLength
 
 var (
-	procGetDiskFreeSpaceExW              = common.Modkernel32.NewProc("GetDiskFreeSpaceExW")
-	procGetLogicalDriveStringW           = common.Modkernel32.NewProc("GetLogicalDriveStringsW")
-	procGetDriveTypeW                    = common.Modkernel32.NewProc("GetDriveTypeW")
-	procGetVolumeInformationW            = common.Modkernel32.NewProc("GetVolumeInformationW")
-	procFindFirstVolumeW                 = common.Modkernel32.NewProc("FindFirstVolumeW")
-	procFindNextVolumeW                  = common.Modkernel32.NewProc("FindNextVolumeW")
-	procFindVolumeClose                  = common.Modkernel32.NewProc("FindVolumeClose")
-	procGetVolumePathNamesForVolumeNameW = common.Modkernel32.NewProc("GetVolumePathNamesForVolumeNameW")
+	procGetDiskFreeSpaceExW               = common.Modkernel32.NewProc("GetDiskFreeSpaceExW")
+	procGetLogicalDriveStringW            = common.Modkernel32.NewProc("GetLogicalDriveStringsW")
+	procGetDriveTypeW                     = common.Modkernel32.NewProc("GetDriveTypeW")
+	procGetVolumeInformationW             = common.Modkernel32.NewProc("GetVolumeInformationW")
+	procFindFirstVolumeW                  = common.Modkernel32.NewProc("FindFirstVolumeW")
+	procFindNextVolumeW                   = common.Modkernel32.NewProc("FindNextVolumeW")
+	procFindVolumeClose                   = common.Modkernel32.NewProc("FindVolumeClose")
+	procGetVolumePathNamesForVolumeNameW  = common.Modkernel32.NewProc("GetVolumePathNamesForVolumeNameW")
+	procGetVolumePathNameW                = common.Modkernel32.NewProc("GetVolumePathNameW")
+	procFindFirstVolumeMountPointW        = common.Modkernel32.NewProc("FindFirstVolumeMountPointW")
+	procFindNextVolumeMountPointW         = common.Modkernel32.NewProc("FindNextVolumeMountPointW")
+	procFindVolumeMountPointClose         = common.Modkernel32.NewProc("FindVolumeMountPointClose")
+	procGetVolumeNameForVolumeMountPointW = common.Modkernel32.NewProc("GetVolumeNameForVolumeMountPointW")
 )
 
 var (
@@ -35,6 +41,38 @@ var (
 	fileReadOnlyVolume  = int64(524288) // 0x00080000
 )
 
+// storagePropertyQuery is an equivalent representation of STORAGE_PROPERTY_QUERY in the Windows API.
+// https://docs.microsoft.com/en-us/windows/win32/api/winioctl/ns-winioctl-storage_property_query
+type storagePropertyQuery struct {
+	PropertyId           uint32
+	QueryType            uint32
+	AdditionalParameters [1]byte
+}
+
+// storageDeviceDescriptor is an equivalent representation of STORAGE_DEVICE_DESCRIPTOR in the Windows API.
+// https://docs.microsoft.com/en-us/windows/win32/api/winioctl/ns-winioctl-storage_device_descriptor
+type storageDeviceDescriptor struct {
+	Version               uint32
+	Size                  uint32
+	DeviceType            byte
+	DeviceTypeModifier    byte
+	RemovableMedia        byte
+	CommandQueueing       byte
+	VendorIdOffset        uint32
+	ProductIdOffset       uint32
+	ProductRevisionOffset uint32
+	SerialNumberOffset    uint32
+	BusType               uint32
+	RawPropertiesLength   uint32
+	RawDeviceProperties   [1]byte
+}
+
+const (
+	ioctlStorageQueryProperty = 0x2D1400
+	storageDeviceProperty     = 0
+	propertyStandardQuery     = 0
+)
+
 // diskPerformance is an equivalent representation of DISK_PERFORMANCE in the Windows API.
 // https://docs.microsoft.com/fr-fr/windows/win32/api/winioctl/ns-winioctl-disk_performance
 type diskPerformance struct {
@@ -80,14 +118,97 @@ func UsageWithContext(ctx context.Context, path string) (*UsageStat, error) {
 		Free:        uint64(lpTotalNumberOfFreeBytes),
 		Used:        uint64(lpTotalNumberOfBytes) - uint64(lpTotalNumberOfFreeBytes),
 		UsedPercent: (float64(lpTotalNumberOfBytes) - float64(lpTotalNumberOfFreeBytes)) / float64(lpTotalNumberOfBytes) * 100,
-		// InodesTotal: 0,
-		// InodesFree: 0,
-		// InodesUsed: 0,
-		// InodesUsedPercent: 0,
 	}
+
+	if fsType, ok := getFsType(path); ok {
+		ret.Fstype = fsType
+		if strings.EqualFold(fsType, "NTFS") {
+			fillNTFSInodeStats(ret, path)
+		}
+	}
+
 	return ret, nil
 }
 
+// getFsType returns the filesystem name (e.g. "NTFS", "ReFS", "FAT32") for
+// path via GetVolumeInformationW, the same call PartitionsWithContext uses to
+// populate PartitionStat.Fstype.
+func getFsType(path string) (string, bool) {
+	rootPtr, err := windows.UTF16PtrFromString(diskRootPath(path))
+	if err != nil {
+		return "", false
+	}
+	volNameBuf, fsNameBuf := make([]uint16, 256), make([]uint16, 256)
+	volSerialNum, maxComponentLen, fsFlags := uint32(0), uint32(0), uint32(0)
+	ret, _, _ := procGetVolumeInformationW.Call(
+		uintptr(unsafe.Pointer(rootPtr)),
+		uintptr(unsafe.Pointer(&volNameBuf[0])),
+		uintptr(len(volNameBuf)),
+		uintptr(unsafe.Pointer(&volSerialNum)),
+		uintptr(unsafe.Pointer(&maxComponentLen)),
+		uintptr(unsafe.Pointer(&fsFlags)),
+		uintptr(unsafe.Pointer(&fsNameBuf[0])),
+		uintptr(len(fsNameBuf)))
+	if ret == 0 {
+		return "", false
+	}
+	return windows.UTF16PtrToString(&fsNameBuf[0]), true
+}
+
+// ntfsVolumeDataBuffer is an equivalent representation of
+// NTFS_VOLUME_DATA_BUFFER in the Windows API.
+// https://docs.microsoft.com/en-us/windows/win32/api/winioctl/ns-winioctl-ntfs_volume_data_buffer
+type ntfsVolumeDataBuffer struct {
+	VolumeSerialNumber           int64
+	NumberSectors                int64
+	TotalClusters                int64
+	FreeClusters                 int64
+	TotalReserved                int64
+	BytesPerSector               uint32
+	BytesPerCluster              uint32
+	BytesPerFileRecordSegment    uint32
+	ClustersPerFileRecordSegment uint32
+	MftValidDataLength           int64
+	MftStartLcn                  int64
+	Mft2StartLcn                 int64
+	MftZoneStart                 int64
+	MftZoneEnd                   int64
+}
+
+const fsctlGetNtfsVolumeData = 0x90064
+
+// fillNTFSInodeStats populates ret's Inodes* fields for the NTFS volume at
+// path via FSCTL_GET_NTFS_VOLUME_DATA. InodesUsed is derived from the current
+// size of the $MFT (MftValidDataLength / BytesPerFileRecordSegment), and
+// InodesFree approximates the file records that could still fit in the
+// volume's unallocated space. The fields are left at zero (the caller only
+// calls this for NTFS, but the ioctl can still fail, e.g. on a locked
+// volume) if the ioctl fails.
+func fillNTFSInodeStats(ret *UsageStat, path string) {
+	device := `\\.\` + strings.TrimRight(strings.TrimPrefix(diskRootPath(path), `\\?\`), `\`)
+	h, err := windows.CreateFile(syscall.StringToUTF16Ptr(device), 0, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return
+	}
+	defer windows.CloseHandle(h)
+
+	var volData ntfsVolumeDataBuffer
+	var bytesReturned uint32
+	if err := windows.DeviceIoControl(h, fsctlGetNtfsVolumeData, nil, 0, (*byte)(unsafe.Pointer(&volData)), uint32(unsafe.Sizeof(volData)), &bytesReturned, nil); err != nil {
+		return
+	}
+	if volData.BytesPerFileRecordSegment == 0 {
+		return
+	}
+
+	ret.InodesUsed = uint64(volData.MftValidDataLength) / uint64(volData.BytesPerFileRecordSegment)
+	ret.InodesFree = (uint64(volData.FreeClusters) * uint64(volData.BytesPerCluster)) / uint64(volData.BytesPerFileRecordSegment)
+	ret.InodesTotal = ret.InodesUsed + ret.InodesFree
+	if ret.InodesTotal > 0 {
+		ret.InodesUsedPercent = float64(ret.InodesUsed) / float64(ret.InodesTotal) * 100
+	}
+}
+
 // PartitionsWithContext returns disk partitions.
 // Since GetVolumeInformation doesn't have a timeout, this method uses context to set deadline by users.
 func PartitionsWithContext(ctx context.Context, all bool) ([]PartitionStat, error) {
@@ -148,6 +269,14 @@ func PartitionsWithContext(ctx context.Context, all bool) ([]PartitionStat, erro
 				}
 				for _, volPath := range volPaths {
 					if driveType == windows.DRIVE_REMOVABLE || driveType == windows.DRIVE_FIXED || driveType == windows.DRIVE_REMOTE || driveType == windows.DRIVE_CDROM {
+						if !isDriveLetterRoot(volPath) {
+							// volPath is a directory mount point (e.g. "C:\mnt\data\"),
+							// not this volume's own drive letter. It's reported by its
+							// host drive's getVolumeMountPointPartitions traversal below
+							// instead, so skip it here to avoid emitting it twice.
+							continue
+						}
+
 						fsFlags, fsNameBuf := uint32(0), make([]uint16, 256)
 						rootPathPtr, _ := windows.UTF16PtrFromString(volPath)
 						volNameBuf := make([]uint16, 256)
@@ -189,6 +318,14 @@ func PartitionsWithContext(ctx context.Context, all bool) ([]PartitionStat, erro
 						case <-quitChan:
 							return
 						}
+
+						for _, mp := range getVolumeMountPointPartitions(volPath, &warnings) {
+							select {
+							case retChan <- mp:
+							case <-quitChan:
+								return
+							}
+						}
 					}
 				}
 			}
@@ -228,6 +365,102 @@ func getVolumePaths(volNameBuf []uint16) ([]string, error) {
 	return split0(volPathsBuf, int(returnLen)), nil
 }
 
+// isDriveLetterRoot reports whether volPath is a bare drive-letter root such
+// as `C:\`, as opposed to a directory mount point path like `C:\mnt\data\`.
+// GetVolumePathNamesForVolumeNameW returns both forms, and directory mount
+// points are already surfaced via getVolumeMountPointPartitions from their
+// host drive, so callers use this to avoid reporting them twice.
+func isDriveLetterRoot(volPath string) bool {
+	trimmed := strings.TrimRight(volPath, `\`)
+	return len(trimmed) == 2 && trimmed[1] == ':'
+}
+
+// getVolumeMountPointPartitions traverses the NTFS mount points rooted directly
+// under rootPath (e.g. "C:\") via FindFirstVolumeMountPointW/
+// FindNextVolumeMountPointW and returns a PartitionStat for each one, with
+// Device set to the underlying "\\?\Volume{GUID}\" name of the mounted volume
+// and Mountpoint set to the resolved folder path. This lets callers see a
+// folder-mounted volume as distinct from its host volume, the way findmnt
+// does on Linux. Failures are reported through warnings rather than returned,
+// since a single bad mount point shouldn't abort the rest of the traversal.
+func getVolumeMountPointPartitions(rootPath string, warnings *Warnings) []PartitionStat {
+	var stats []PartitionStat
+
+	rootPtr, err := windows.UTF16PtrFromString(rootPath)
+	if err != nil {
+		warnings.Add(fmt.Errorf("failed to convert root path %s: %w", rootPath, err))
+		return stats
+	}
+
+	mountPointBuf := make([]uint16, windows.MAX_PATH+1)
+	findHandle, _, err := procFindFirstVolumeMountPointW.Call(
+		uintptr(unsafe.Pointer(rootPtr)),
+		uintptr(unsafe.Pointer(&mountPointBuf[0])),
+		uintptr(len(mountPointBuf)))
+	if windows.Handle(findHandle) == windows.InvalidHandle {
+		// No mount points under this root; most volumes fall in this case.
+		return stats
+	}
+	defer procFindVolumeMountPointClose.Call(findHandle)
+
+	for {
+		fullPath := strings.TrimRight(rootPath, `\`) + `\` + strings.TrimLeft(windows.UTF16ToString(mountPointBuf), `\`)
+		fullPathPtr, _ := windows.UTF16PtrFromString(fullPath)
+
+		volNameBuf := make([]uint16, volumeNameBufferLength)
+		if ret, _, err := procGetVolumeNameForVolumeMountPointW.Call(
+			uintptr(unsafe.Pointer(fullPathPtr)),
+			uintptr(unsafe.Pointer(&volNameBuf[0])),
+			uintptr(volumeNameBufferLength)); ret == 0 {
+			warnings.Add(fmt.Errorf("failed to resolve volume for mount point %s: %w", fullPath, err))
+		} else {
+			fsFlags, fsNameBuf := uint32(0), make([]uint16, 256)
+			volSerialNum, maxComponentLen := uint32(0), uint32(0)
+			mpVolNameBuf := make([]uint16, 256)
+			if driveRet, _, err := procGetVolumeInformationW.Call(
+				uintptr(unsafe.Pointer(fullPathPtr)),
+				uintptr(unsafe.Pointer(&mpVolNameBuf[0])),
+				uintptr(len(mpVolNameBuf)),
+				uintptr(unsafe.Pointer(&volSerialNum)),
+				uintptr(unsafe.Pointer(&maxComponentLen)),
+				uintptr(unsafe.Pointer(&fsFlags)),
+				uintptr(unsafe.Pointer(&fsNameBuf[0])),
+				uintptr(len(fsNameBuf))); driveRet == 0 {
+				warnings.Add(fmt.Errorf("failed to get volume information for mount point %s: %w", fullPath, err))
+			} else {
+				opts := []string{"rw"}
+				if int64(fsFlags)&fileReadOnlyVolume != 0 {
+					opts = []string{"ro"}
+				}
+				if int64(fsFlags)&fileFileCompression != 0 {
+					opts = append(opts, "compress")
+				}
+				stats = append(stats, PartitionStat{
+					Device:     windows.UTF16ToString(volNameBuf),
+					Mountpoint: strings.TrimRight(fullPath, `\`),
+					Fstype:     windows.UTF16PtrToString(&fsNameBuf[0]),
+					Opts:       opts,
+				})
+			}
+		}
+
+		mountPointBuf = make([]uint16, windows.MAX_PATH+1)
+		ret, _, err := procFindNextVolumeMountPointW.Call(
+			findHandle,
+			uintptr(unsafe.Pointer(&mountPointBuf[0])),
+			uintptr(len(mountPointBuf)))
+		if ret == 0 {
+			if errno, ok := err.(syscall.Errno); ok && errno == windows.ERROR_NO_MORE_FILES {
+				break
+			}
+			warnings.Add(fmt.Errorf("failed to find next volume mount point under %s: %w", rootPath, err))
+			break
+		}
+	}
+
+	return stats
+}
+
 // split0 iterates through s16 upto `end` and slices `s16` into sub-slices separated by the null character (uint16(0)).
 // split0 converts the sub-slices between the null characters into strings then returns them in a slice.
 func split0(s16 []uint16, end int) []string {
@@ -249,27 +482,54 @@ func split0(s16 []uint16, end int) []string {
 	return ss
 }
 
+// ioCounterResult pairs a drive name with its counters so they can travel
+// together over retChan below.
+type ioCounterResult struct {
+	name string
+	stat IOCountersStat
+}
+
+// IOCountersWithContext returns IO counters per logical drive letter.
+// CreateFile/DeviceIoControl against a flaky removable or network-backed
+// drive can hang, so drives are enumerated in a worker goroutine and ctx is
+// consulted the same way PartitionsWithContext does, returning whatever has
+// been collected so far if the deadline fires. Each drive's handle is closed
+// as soon as that drive is processed rather than accumulated via defer, so a
+// long enumeration can't leak handles until the function returns.
 func IOCountersWithContext(ctx context.Context, names ...string) (map[string]IOCountersStat, error) {
 	// https://github.com/giampaolo/psutil/blob/544e9daa4f66a9f80d7bf6c7886d693ee42f0a13/psutil/arch/windows/disk.c#L83
 	drivemap := make(map[string]IOCountersStat, 0)
-	var diskPerformance diskPerformance
 
-	lpBuffer := make([]uint16, 254)
-	lpBufferLen, err := windows.GetLogicalDriveStrings(uint32(len(lpBuffer)), &lpBuffer[0])
-	if err != nil {
-		return drivemap, err
-	}
-	for _, v := range lpBuffer[:lpBufferLen] {
-		if 'A' <= v && v <= 'Z' {
+	var enumErr error
+	retChan := make(chan ioCounterResult)
+	quitChan := make(chan struct{})
+	defer close(quitChan)
+
+	getIOCounters := func() {
+		defer close(retChan)
+
+		lpBuffer := make([]uint16, 254)
+		lpBufferLen, err := windows.GetLogicalDriveStrings(uint32(len(lpBuffer)), &lpBuffer[0])
+		if err != nil {
+			enumErr = err
+			return
+		}
+		for _, v := range lpBuffer[:lpBufferLen] {
+			if v < 'A' || v > 'Z' {
+				continue
+			}
+
 			path := string(rune(v)) + ":"
 			typepath, _ := windows.UTF16PtrFromString(path)
 			typeret := windows.GetDriveType(typepath)
 			if typeret == 0 {
-				return drivemap, windows.GetLastError()
+				enumErr = windows.GetLastError()
+				return
 			}
 			if typeret != windows.DRIVE_FIXED {
 				continue
 			}
+
 			szDevice := fmt.Sprintf(`\\.\%s`, path)
 			const IOCTL_DISK_PERFORMANCE = 0x70020
 			h, err := windows.CreateFile(syscall.StringToUTF16Ptr(szDevice), 0, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
@@ -277,16 +537,20 @@ func IOCountersWithContext(ctx context.Context, names ...string) (map[string]IOC
 				if err == windows.ERROR_FILE_NOT_FOUND {
 					continue
 				}
-				return drivemap, err
+				enumErr = err
+				return
 			}
-			defer windows.CloseHandle(h)
 
+			var diskPerformance diskPerformance
 			var diskPerformanceSize uint32
 			err = windows.DeviceIoControl(h, IOCTL_DISK_PERFORMANCE, nil, 0, (*byte)(unsafe.Pointer(&diskPerformance)), uint32(unsafe.Sizeof(diskPerformance)), &diskPerformanceSize, nil)
+			windows.CloseHandle(h)
 			if err != nil {
-				return drivemap, err
+				enumErr = err
+				return
 			}
-			drivemap[path] = IOCountersStat{
+
+			stat := IOCountersStat{
 				ReadBytes:  uint64(diskPerformance.BytesRead),
 				WriteBytes: uint64(diskPerformance.BytesWritten),
 				ReadCount:  uint64(diskPerformance.ReadCount),
@@ -295,15 +559,279 @@ func IOCountersWithContext(ctx context.Context, names ...string) (map[string]IOC
 				WriteTime:  uint64(diskPerformance.WriteTime / 10000 / 1000),
 				Name:       path,
 			}
+
+			select {
+			case retChan <- ioCounterResult{name: path, stat: stat}:
+			case <-quitChan:
+				return
+			}
+		}
+	}
+
+	go getIOCounters()
+
+	for {
+		select {
+		case r, ok := <-retChan:
+			if !ok {
+				return drivemap, enumErr
+			}
+			drivemap[r.name] = r.stat
+		case <-ctx.Done():
+			return drivemap, ctx.Err()
 		}
 	}
-	return drivemap, nil
 }
 
+// physicalDriveProbeLimit is the number of PhysicalDriveN indexes (0 through
+// physicalDriveProbeLimit-1) that IOCountersPhysicalWithContext probes.
+const physicalDriveProbeLimit = 64
+
+// IOCountersPhysicalWithContext returns IO counters keyed by physical disk
+// (PhysicalDrive0, PhysicalDrive1, ...) rather than by logical drive letter,
+// so callers can get per-spindle/per-NVMe-device counters instead of having
+// them double-counted across every partition on the same disk. If names is
+// non-empty, only physical drives whose name appears in it are returned.
+// Physical drive numbers aren't guaranteed contiguous (removing
+// PhysicalDrive1 leaves PhysicalDrive2 in place), so every index up to
+// physicalDriveProbeLimit is probed rather than stopping at the first one
+// that's missing. Devices are enumerated in a worker goroutine and ctx is
+// consulted the same way IOCountersWithContext does, returning whatever has
+// been collected so far if the deadline fires; a device that doesn't support
+// IOCTL_DISK_PERFORMANCE (some optical/removable drives) is skipped rather
+// than aborting the rest of the enumeration.
+func IOCountersPhysicalWithContext(ctx context.Context, names ...string) (map[string]IOCountersStat, error) {
+	drivemap := make(map[string]IOCountersStat, 0)
+
+	nameFilter := make(map[string]bool, len(names))
+	for _, name := range names {
+		nameFilter[name] = true
+	}
+
+	var enumErr error
+	retChan := make(chan ioCounterResult)
+	quitChan := make(chan struct{})
+	defer close(quitChan)
+
+	getIOCounters := func() {
+		defer close(retChan)
+
+		const IOCTL_DISK_PERFORMANCE = 0x70020
+		for i := 0; i < physicalDriveProbeLimit; i++ {
+			driveName := fmt.Sprintf("PhysicalDrive%d", i)
+			szDevice := fmt.Sprintf(`\\.\%s`, driveName)
+			h, err := windows.CreateFile(syscall.StringToUTF16Ptr(szDevice), 0, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+			if err != nil {
+				if err == windows.ERROR_FILE_NOT_FOUND {
+					continue
+				}
+				enumErr = err
+				return
+			}
+
+			if len(nameFilter) > 0 && !nameFilter[driveName] {
+				windows.CloseHandle(h)
+				continue
+			}
+
+			var perf diskPerformance
+			var perfSize uint32
+			err = windows.DeviceIoControl(h, IOCTL_DISK_PERFORMANCE, nil, 0, (*byte)(unsafe.Pointer(&perf)), uint32(unsafe.Sizeof(perf)), &perfSize, nil)
+			if err != nil {
+				// Some physical drives (optical, certain removable media) don't
+				// support IOCTL_DISK_PERFORMANCE; skip them rather than failing
+				// the whole enumeration.
+				windows.CloseHandle(h)
+				continue
+			}
+			name := physicalDriveFriendlyName(h, driveName)
+			windows.CloseHandle(h)
+
+			stat := IOCountersStat{
+				ReadBytes:  uint64(perf.BytesRead),
+				WriteBytes: uint64(perf.BytesWritten),
+				ReadCount:  uint64(perf.ReadCount),
+				WriteCount: uint64(perf.WriteCount),
+				ReadTime:   uint64(perf.ReadTime / 10000 / 1000),
+				WriteTime:  uint64(perf.WriteTime / 10000 / 1000),
+				Name:       name,
+			}
+
+			select {
+			case retChan <- ioCounterResult{name: driveName, stat: stat}:
+			case <-quitChan:
+				return
+			}
+		}
+	}
+
+	go getIOCounters()
+
+	for {
+		select {
+		case r, ok := <-retChan:
+			if !ok {
+				return drivemap, enumErr
+			}
+			drivemap[r.name] = r.stat
+		case <-ctx.Done():
+			return drivemap, ctx.Err()
+		}
+	}
+}
+
+// physicalDriveFriendlyName queries IOCTL_STORAGE_QUERY_PROPERTY on the
+// already-open handle h for the STORAGE_DEVICE_DESCRIPTOR of a physical drive
+// and returns "<vendor> <product>", aligned with what psutil reports for
+// physical disks. It falls back to driveName if the query fails or the
+// device doesn't report a vendor/product id.
+func physicalDriveFriendlyName(h windows.Handle, driveName string) string {
+	query := storagePropertyQuery{PropertyId: storageDeviceProperty, QueryType: propertyStandardQuery}
+	buf := make([]byte, 1024)
+	var bytesReturned uint32
+	if err := windows.DeviceIoControl(h, ioctlStorageQueryProperty,
+		(*byte)(unsafe.Pointer(&query)), uint32(unsafe.Sizeof(query)),
+		&buf[0], uint32(len(buf)), &bytesReturned, nil); err != nil {
+		return driveName
+	}
+	if len(buf) < int(unsafe.Sizeof(storageDeviceDescriptor{})) {
+		return driveName
+	}
+	descriptor := (*storageDeviceDescriptor)(unsafe.Pointer(&buf[0]))
+	friendly := strings.TrimSpace(strings.TrimSpace(storageDescriptorString(buf, descriptor.VendorIdOffset)) + " " + storageDescriptorString(buf, descriptor.ProductIdOffset))
+	if friendly == "" {
+		return driveName
+	}
+	return friendly
+}
+
+// diskRootPath normalizes name into a volume root path suitable for
+// GetVolumeInformationW. A "\\?\Volume{GUID}\" volume name is used as-is,
+// with a trailing backslash added if it lacks one; anything else (a drive letter, a root path, or an arbitrary sub-directory
+// such as "C:\Users") is resolved to its volume's mount point via
+// GetVolumePathNameW, so callers don't need to pass a root path themselves.
+func diskRootPath(name string) string {
+	name = strings.TrimSpace(name)
+	if strings.HasPrefix(name, `\\?\Volume`) {
+		if !strings.HasSuffix(name, `\`) {
+			name += `\`
+		}
+		return name
+	}
+
+	fallback := strings.TrimRight(name, `\`) + `\`
+	namePtr, err := windows.UTF16PtrFromString(fallback)
+	if err != nil {
+		return fallback
+	}
+	rootBuf := make([]uint16, windows.MAX_PATH+1)
+	if ret, _, _ := procGetVolumePathNameW.Call(
+		uintptr(unsafe.Pointer(namePtr)),
+		uintptr(unsafe.Pointer(&rootBuf[0])),
+		uintptr(len(rootBuf))); ret == 0 {
+		return fallback
+	}
+	return windows.UTF16ToString(rootBuf)
+}
+
+// storageDescriptorString reads a null-terminated ASCII string out of buf at
+// offset, as used by the *Offset fields of STORAGE_DEVICE_DESCRIPTOR. It
+// returns "" if offset is unset (0) or out of range.
+func storageDescriptorString(buf []byte, offset uint32) string {
+	if offset == 0 || int(offset) >= len(buf) {
+		return ""
+	}
+	b := buf[offset:]
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// storageDeviceSerialNumber queries the hardware serial number of the device
+// backing devicePath (e.g. `\\.\C:` or `\\.\PhysicalDrive0`) via
+// IOCTL_STORAGE_QUERY_PROPERTY. Many drivers only populate the serial number
+// for callers holding administrative rights, in which case this returns "".
+func storageDeviceSerialNumber(devicePath string) (string, error) {
+	h, err := windows.CreateFile(syscall.StringToUTF16Ptr(devicePath), 0, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return "", err
+	}
+	defer windows.CloseHandle(h)
+
+	query := storagePropertyQuery{PropertyId: storageDeviceProperty, QueryType: propertyStandardQuery}
+	buf := make([]byte, 1024)
+	var bytesReturned uint32
+	if err := windows.DeviceIoControl(h, ioctlStorageQueryProperty,
+		(*byte)(unsafe.Pointer(&query)), uint32(unsafe.Sizeof(query)),
+		&buf[0], uint32(len(buf)), &bytesReturned, nil); err != nil {
+		return "", err
+	}
+	if len(buf) < int(unsafe.Sizeof(storageDeviceDescriptor{})) {
+		return "", nil
+	}
+	descriptor := (*storageDeviceDescriptor)(unsafe.Pointer(&buf[0]))
+	return storageDescriptorString(buf, descriptor.SerialNumberOffset), nil
+}
+
+// SerialNumberWithContext returns the serial number of the volume or drive
+// identified by name, which may be a drive letter ("C:"), a root path
+// ("C:\"), or a "\\?\Volume{GUID}\" volume name. It prefers the hardware
+// serial reported by the underlying physical device and falls back to the
+// 32-bit volume serial number from GetVolumeInformationW, formatted the way
+// Windows Explorer displays it (XXXX-XXXX), when the hardware query fails or
+// requires elevation the caller doesn't have.
 func SerialNumberWithContext(ctx context.Context, name string) (string, error) {
-	return "", common.ErrNotImplementedError
+	root := diskRootPath(name)
+	devicePath := `\\.\` + strings.TrimRight(strings.TrimPrefix(root, `\\?\`), `\`)
+
+	if serial, err := storageDeviceSerialNumber(devicePath); err == nil && serial != "" {
+		return serial, nil
+	}
+
+	rootPtr, err := windows.UTF16PtrFromString(root)
+	if err != nil {
+		return "", err
+	}
+	volNameBuf, fsNameBuf := make([]uint16, 256), make([]uint16, 256)
+	volSerialNum, maxComponentLen, fsFlags := uint32(0), uint32(0), uint32(0)
+	ret, _, err := procGetVolumeInformationW.Call(
+		uintptr(unsafe.Pointer(rootPtr)),
+		uintptr(unsafe.Pointer(&volNameBuf[0])),
+		uintptr(len(volNameBuf)),
+		uintptr(unsafe.Pointer(&volSerialNum)),
+		uintptr(unsafe.Pointer(&maxComponentLen)),
+		uintptr(unsafe.Pointer(&fsFlags)),
+		uintptr(unsafe.Pointer(&fsNameBuf[0])),
+		uintptr(len(fsNameBuf)))
+	if ret == 0 {
+		return "", err
+	}
+	return fmt.Sprintf("%04X-%04X", volSerialNum>>16, volSerialNum&0xFFFF), nil
 }
 
+// LabelWithContext returns the volume label of the volume or drive identified
+// by name, which may be a drive letter ("C:"), a root path ("C:\"), or a
+// "\\?\Volume{GUID}\" volume name.
 func LabelWithContext(ctx context.Context, name string) (string, error) {
-	return "", common.ErrNotImplementedError
+	root := diskRootPath(name)
+	rootPtr, err := windows.UTF16PtrFromString(root)
+	if err != nil {
+		return "", err
+	}
+	volNameBuf, fsNameBuf := make([]uint16, 256), make([]uint16, 256)
+	volSerialNum, maxComponentLen, fsFlags := uint32(0), uint32(0), uint32(0)
+	ret, _, err := procGetVolumeInformationW.Call(
+		uintptr(unsafe.Pointer(rootPtr)),
+		uintptr(unsafe.Pointer(&volNameBuf[0])),
+		uintptr(len(volNameBuf)),
+		uintptr(unsafe.Pointer(&volSerialNum)),
+		uintptr(unsafe.Pointer(&maxComponentLen)),
+		uintptr(unsafe.Pointer(&fsFlags)),
+		uintptr(unsafe.Pointer(&fsNameBuf[0])),
+		uintptr(len(fsNameBuf)))
+	if ret == 0 {
+		return "", err
+	}
+	return windows.UTF16ToString(volNameBuf), nil
 }
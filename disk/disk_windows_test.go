@@ -0,0 +1,83 @@
+//go:build windows
+// +build windows
+
+package disk
+
+import "testing"
+
+func TestDiskRootPath(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"drive letter", "C:", `C:\`},
+		{"root path", `C:\`, `C:\`},
+		{"volume guid", `\\?\Volume{12345678-1234-1234-1234-123456789abc}`, `\\?\Volume{12345678-1234-1234-1234-123456789abc}\`},
+		{"volume guid with trailing slash", `\\?\Volume{12345678-1234-1234-1234-123456789abc}\`, `\\?\Volume{12345678-1234-1234-1234-123456789abc}\`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := diskRootPath(c.in)
+			if got != c.want {
+				t.Errorf("diskRootPath(%q) = %q, want %q", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDiskRootPathSubDirectory(t *testing.T) {
+	// A sub-directory should resolve to its volume's root, not just have
+	// its own trailing components trimmed.
+	got := diskRootPath(`C:\Windows\System32`)
+	if got != `C:\` {
+		t.Errorf(`diskRootPath(C:\Windows\System32) = %q, want C:\`, got)
+	}
+}
+
+func TestIsDriveLetterRoot(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{"drive root", `C:\`, true},
+		{"drive letter without backslash", `C:`, true},
+		{"directory mount point", `C:\mnt\data\`, false},
+		{"volume guid", `\\?\Volume{12345678-1234-1234-1234-123456789abc}\`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := isDriveLetterRoot(c.in)
+			if got != c.want {
+				t.Errorf("isDriveLetterRoot(%q) = %v, want %v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestStorageDescriptorString(t *testing.T) {
+	cases := []struct {
+		name   string
+		buf    []byte
+		offset uint32
+		want   string
+	}{
+		{"zero offset", []byte("ignored\x00"), 0, ""},
+		{"offset out of range", []byte("short"), 100, ""},
+		{"offset at end of buffer", []byte("short"), 5, ""},
+		{"null terminated", append([]byte{0, 0, 0, 0}, append([]byte("ACME  "), 0, 'x', 'x')...), 4, "ACME"},
+		{"unterminated buffer", append([]byte{0, 0, 0, 0}, []byte(" ACME-1234")...), 4, "ACME-1234"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := storageDescriptorString(c.buf, c.offset)
+			if got != c.want {
+				t.Errorf("storageDescriptorString(%v, %d) = %q, want %q", c.buf, c.offset, got, c.want)
+			}
+		})
+	}
+}